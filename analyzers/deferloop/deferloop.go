@@ -0,0 +1,140 @@
+// Package deferloop defines an analyzer that flags defer statements
+// executed inside loops.
+package deferloop
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `report defer statements that run inside a loop
+
+A defer executed inside a for or range loop only fires when the
+enclosing function returns, not at the end of each iteration. When
+the loop opens a resource per iteration (a file, a sql.Rows, an HTTP
+response body, ...), this delays cleanup until the function exits and
+can exhaust file descriptors or connections long before that happens.
+
+A defer inside a function literal that is itself called on every
+iteration is not flagged: the literal is its own function, so the
+defer still runs once per iteration.`
+
+// Analyzer reports defer statements whose nearest enclosing loop is not
+// separated from them by an intervening function literal.
+var Analyzer = &analysis.Analyzer{
+	Name:     "deferloop",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/sharafdin/yoauditor/analyzers/deferloop",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.DeferStmt)(nil)}
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		def := n.(*ast.DeferStmt)
+		loop, body := enclosingLoop(stack)
+		if loop == nil {
+			return true
+		}
+		reportDeferInLoop(pass, def, loop, body)
+		return true
+	})
+
+	return nil, nil
+}
+
+// enclosingLoop walks stack (the ancestors of the current node, nearest
+// last) looking for the nearest *ast.ForStmt or *ast.RangeStmt that isn't
+// shielded from the current node by a *ast.FuncLit boundary. It returns
+// the loop node and its body, or nil if the defer is safe.
+func enclosingLoop(stack []ast.Node) (loop ast.Node, body *ast.BlockStmt) {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncLit:
+			return nil, nil
+		case *ast.ForStmt:
+			return n, n.Body
+		case *ast.RangeStmt:
+			return n, n.Body
+		}
+	}
+	return nil, nil
+}
+
+func reportDeferInLoop(pass *analysis.Pass, def *ast.DeferStmt, loop ast.Node, loopBody *ast.BlockStmt) {
+	diag := analysis.Diagnostic{
+		Pos:     def.Pos(),
+		End:     def.End(),
+		Message: fmt.Sprintf("defer %s runs only when the function returns, not at the end of this loop iteration (leaks until then)", nodeString(pass.Fset, def.Call)),
+	}
+
+	if fix, ok := buildFix(pass, def, loopBody); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+
+	pass.Report(diag)
+}
+
+// buildFix rewrites a `defer x.Close()`-shaped statement (no arguments, a
+// single selector call) into an explicit call in the same position. It
+// only fires when def is the last statement of the loop body: that's the
+// only case where replacing it in place is guaranteed not to leave a
+// later statement in the same iteration reading or writing the
+// now-closed receiver. Anything else (a defer followed by more code, as
+// in the nested-loop shape) is left for a human to fix, since proving
+// none of the trailing statements still use the receiver would require
+// real data-flow analysis.
+func buildFix(pass *analysis.Pass, def *ast.DeferStmt, loopBody *ast.BlockStmt) (analysis.SuggestedFix, bool) {
+	call := def.Call
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 0 {
+		return analysis.SuggestedFix{}, false
+	}
+	closeCall := fmt.Sprintf("%s.%s()", nodeString(pass.Fset, sel.X), sel.Sel.Name)
+
+	idx, stmts := indexIn(loopBody, def)
+	if idx < 0 || idx != len(stmts)-1 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	return analysis.SuggestedFix{
+		Message: "close explicitly before each exit path instead of deferring",
+		TextEdits: []analysis.TextEdit{
+			{Pos: def.Pos(), End: def.End(), NewText: []byte(closeCall)},
+		},
+	}, true
+}
+
+// indexIn reports the index of def within body's statement list, along
+// with that list, or -1 if def isn't a direct statement of body.
+func indexIn(body *ast.BlockStmt, def *ast.DeferStmt) (int, []ast.Stmt) {
+	for i, stmt := range body.List {
+		if stmt == ast.Stmt(def) {
+			return i, body.List
+		}
+	}
+	return -1, nil
+}
+
+// nodeString renders n back to source text, falling back to a
+// placeholder if printing fails for some reason.
+func nodeString(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		return "<expr>"
+	}
+	return buf.String()
+}
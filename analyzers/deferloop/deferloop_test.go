@@ -0,0 +1,19 @@
+package deferloop_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/sharafdin/yoauditor/analyzers/deferloop"
+)
+
+func TestDeferLoop(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, deferloop.Analyzer, "a", "nestedloop", "httpresp", "sqlrows")
+}
+
+func TestDeferLoopFix(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, deferloop.Analyzer, "a", "nestedloop", "httpresp", "sqlrows")
+}
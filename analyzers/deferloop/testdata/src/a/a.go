@@ -0,0 +1,33 @@
+package a
+
+import "os"
+
+func processFiles(paths []string) {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		defer f.Close() // want `defer f\.Close\(\) runs only when the function returns, not at the end of this loop iteration \(leaks until then\)`
+	}
+}
+
+func safeInClosure(paths []string) {
+	for _, path := range paths {
+		func() {
+			f, err := os.Open(path)
+			if err != nil {
+				return
+			}
+			defer f.Close()
+		}()
+	}
+}
+
+func deferAfterFunction(path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+}
@@ -0,0 +1,14 @@
+package httpresp
+
+import "net/http"
+
+func fetchAll(urls []string) error {
+	for _, u := range urls {
+		resp, err := http.Get(u)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close() // want `defer resp\.Body\.Close\(\) runs only when the function returns, not at the end of this loop iteration \(leaks until then\)`
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package nestedloop
+
+import "os"
+
+func walk(groups [][]string) {
+	for _, group := range groups {
+		for _, path := range group {
+			f, err := os.Open(path)
+			if err != nil {
+				continue
+			}
+			defer f.Close() // want `defer f\.Close\(\) runs only when the function returns, not at the end of this loop iteration \(leaks until then\)`
+			if f.Name() == "" {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package sqlrows
+
+import "database/sql"
+
+func queryAll(db *sql.DB, queries []string) error {
+	for _, q := range queries {
+		rows, err := db.Query(q)
+		if err != nil {
+			return err
+		}
+		defer rows.Close() // want `defer rows\.Close\(\) runs only when the function returns, not at the end of this loop iteration \(leaks until then\)`
+	}
+	return nil
+}
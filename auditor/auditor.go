@@ -0,0 +1,211 @@
+// Package auditor wires yoauditor's analyzers, rule registry, and
+// policy engine into a single pass over a set of Go source files.
+package auditor
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/sharafdin/yoauditor/analyzers/deferloop"
+	"github.com/sharafdin/yoauditor/envcheck"
+	"github.com/sharafdin/yoauditor/internal/gofiles"
+	"github.com/sharafdin/yoauditor/policy"
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+// Finding is one issue found in a source file, regardless of which
+// subsystem (analyzer, rule, or policy) produced it.
+type Finding struct {
+	RuleID   string
+	Severity rules.Severity
+	Message  string
+	Pos      token.Position
+	// Snippet is the source line Pos points at, if known.
+	Snippet string
+	// SuggestedFix describes the fix the CLI's -fix flag would apply,
+	// if the finding's subsystem offers one.
+	SuggestedFix string
+}
+
+// Options controls which subsystems Run exercises.
+type Options struct {
+	// PolicyDir, if set, additionally evaluates the Rego bundle at this
+	// path (directory or compressed bundle file) against every file.
+	PolicyDir string
+	// UseGosec, if true, also runs the gosec rules wrapped by the rules
+	// package over paths.
+	UseGosec bool
+	// ConfigPath, if set, loads a .yoauditor.yml from this path and
+	// applies its enable/disable and severity-override state to the
+	// rule registry, covering both yoauditor's own rules and (when
+	// UseGosec is set) gosec's.
+	ConfigPath string
+	// EnvManifestPath, if set, additionally runs envcheck.Scan over
+	// paths against the manifest at this path and reports undeclared
+	// and unused environment variables.
+	EnvManifestPath string
+}
+
+// Run walks paths (files or directories) for Go source and returns
+// every finding from the deferloop analyzer, the rule registry, and
+// (if configured) the policy engine and gosec.
+func Run(paths []string, opts Options) ([]Finding, error) {
+	files, err := gofiles.List(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := rules.NewRegistry()
+	registry.Register(rules.NewGetenvNoDefault())
+
+	if opts.ConfigPath != "" {
+		cfg, err := rules.LoadConfig(opts.ConfigPath)
+		if err != nil {
+			return nil, err
+		}
+		registry.Apply(cfg)
+	}
+
+	var engine *policy.Engine
+	if opts.PolicyDir != "" {
+		engine = policy.NewEngine()
+		if err := engine.LoadBundle(opts.PolicyDir); err != nil {
+			return nil, err
+		}
+	}
+
+	fset := token.NewFileSet()
+	var findings []Finding
+	for _, filename := range files {
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, diag := range runDeferloop(fset, file) {
+			pos := fset.Position(diag.Pos)
+			f := Finding{
+				RuleID:   deferloop.Analyzer.Name,
+				Severity: rules.SeverityHigh,
+				Message:  diag.Message,
+				Pos:      pos,
+				Snippet:  snippetLine(src, pos.Line),
+			}
+			if len(diag.SuggestedFixes) > 0 {
+				f.SuggestedFix = diag.SuggestedFixes[0].Message
+			}
+			findings = append(findings, f)
+		}
+
+		issues, err := registry.Walk(fset, filename, file)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			pos := fset.Position(issue.Pos)
+			findings = append(findings, Finding{
+				RuleID:   issue.RuleID,
+				Severity: issue.Severity,
+				Message:  issue.Message,
+				Pos:      pos,
+				Snippet:  snippetLine(src, pos.Line),
+			})
+		}
+
+		if engine != nil {
+			policyFindings, err := engine.Evaluate(fset, file)
+			if err != nil {
+				return nil, err
+			}
+			for _, pf := range policyFindings {
+				findings = append(findings, Finding{
+					RuleID:   pf.Rule,
+					Severity: pf.Severity,
+					Message:  pf.Message,
+					Pos:      pf.Pos,
+					Snippet:  snippetLine(src, pf.Pos.Line),
+				})
+			}
+		}
+	}
+
+	if opts.UseGosec {
+		gosecIssues, err := rules.RunGosec(paths, rules.DefaultGosecRuleIDs...)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range registry.ApplyOverrides(gosecIssues) {
+			findings = append(findings, Finding{RuleID: issue.RuleID, Severity: issue.Severity, Message: issue.Message})
+		}
+	}
+
+	if opts.EnvManifestPath != "" {
+		manifest, err := envcheck.LoadManifest(opts.EnvManifestPath)
+		if err != nil {
+			return nil, err
+		}
+		envReport, err := envcheck.Scan(paths, manifest)
+		if err != nil {
+			return nil, err
+		}
+		for _, usage := range envReport.Undeclared {
+			findings = append(findings, Finding{
+				RuleID:   "envcheck.undeclared",
+				Severity: rules.SeverityMedium,
+				Message:  fmt.Sprintf("%s is read from the environment but not declared in %s", usage.Name, opts.EnvManifestPath),
+				Pos:      usage.Pos,
+			})
+		}
+		for _, name := range envReport.Unused {
+			findings = append(findings, Finding{
+				RuleID:   "envcheck.unused",
+				Severity: rules.SeverityLow,
+				Message:  fmt.Sprintf("%s is declared in %s but never read", name, opts.EnvManifestPath),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// runDeferloop runs the deferloop analyzer against a single file
+// directly, without a full go/packages load: the analyzer only needs
+// syntax, not type information, so a minimal hand-built pass is enough.
+func runDeferloop(fset *token.FileSet, file *ast.File) []analysis.Diagnostic {
+	insp := inspector.New([]*ast.File{file})
+	var diags []analysis.Diagnostic
+	pass := &analysis.Pass{
+		Fset:     fset,
+		Files:    []*ast.File{file},
+		ResultOf: map[*analysis.Analyzer]interface{}{inspect.Analyzer: insp},
+		Report:   func(d analysis.Diagnostic) { diags = append(diags, d) },
+		ReadFile: os.ReadFile,
+	}
+	// Run's error is always nil for this analyzer; its Diagnostics are
+	// collected via pass.Report above.
+	_, _ = deferloop.Analyzer.Run(pass)
+	return diags
+}
+
+// snippetLine returns line (1-based) of src, trimmed of surrounding
+// whitespace, or "" if line is out of range.
+func snippetLine(src []byte, line int) string {
+	lines := bytes.Split(src, []byte("\n"))
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return strings.TrimSpace(string(lines[line-1]))
+}
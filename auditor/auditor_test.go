@@ -0,0 +1,85 @@
+package auditor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/auditor"
+)
+
+// TestRunEnvManifest checks that Options.EnvManifestPath actually wires
+// envcheck.Scan into Run: without it, envcheck never runs no matter
+// what else is scanned.
+func TestRunEnvManifest(t *testing.T) {
+	dir := t.TempDir()
+	const src = `package a
+
+import "os"
+
+func config() {
+	_ = os.Getenv("PORT")
+	_, _ = os.LookupEnv("LOG_LEVEL")
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "a.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	const manifest = `vars:
+  - name: PORT
+  - name: UNUSED_VAR
+`
+	manifestPath := filepath.Join(dir, "manifest.yml")
+	if err := os.WriteFile(manifestPath, []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := auditor.Run([]string{dir}, auditor.Options{EnvManifestPath: manifestPath})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var gotUndeclared, gotUnused bool
+	for _, f := range findings {
+		switch f.RuleID {
+		case "envcheck.undeclared":
+			gotUndeclared = true
+		case "envcheck.unused":
+			gotUnused = true
+		}
+	}
+	if !gotUndeclared {
+		t.Errorf("Run(...) = %+v, want an envcheck.undeclared finding for LOG_LEVEL", findings)
+	}
+	if !gotUnused {
+		t.Errorf("Run(...) = %+v, want an envcheck.unused finding for UNUSED_VAR", findings)
+	}
+}
+
+// TestRunWithoutEnvManifestSkipsEnvcheck documents that envcheck only
+// runs when a manifest path is supplied.
+func TestRunWithoutEnvManifestSkipsEnvcheck(t *testing.T) {
+	dir := t.TempDir()
+	const src = `package a
+
+import "os"
+
+func config() {
+	_ = os.Getenv("PORT")
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "a.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := auditor.Run([]string{dir}, auditor.Options{})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	for _, f := range findings {
+		if f.RuleID == "envcheck.undeclared" || f.RuleID == "envcheck.unused" {
+			t.Errorf("Run(...) with no EnvManifestPath produced an envcheck finding: %+v", f)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package auditor
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+
+	"github.com/sharafdin/yoauditor/fixer"
+	"github.com/sharafdin/yoauditor/internal/gofiles"
+)
+
+// FixResult is one file yoauditor considered for -fix, whether or not
+// anything about it actually changed.
+type FixResult struct {
+	Filename string
+	Before   []byte
+	After    []byte
+	Changed  bool
+}
+
+// Fix runs the deferloop analyzer's SuggestedFixes over the Go files
+// under paths and, for every file with at least one fix, formats the
+// result with fixer.Apply. If write is true, changed files are
+// rewritten in place; otherwise Fix leaves every file untouched and
+// callers are expected to render FixResult.Before/After themselves
+// (see fixer.Diff).
+//
+// deferloop is the only fixable subsystem today.
+func Fix(paths []string, write bool) ([]FixResult, error) {
+	files, err := gofiles.List(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []FixResult
+	for _, filename := range files {
+		before, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, filename, before, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		diags := runDeferloop(fset, file)
+		if len(diags) == 0 {
+			continue
+		}
+
+		after, err := fixer.Apply(fset, filename, before, diags)
+		if err != nil {
+			return nil, err
+		}
+
+		result := FixResult{Filename: filename, Before: before, After: after, Changed: string(before) != string(after)}
+		if result.Changed && write {
+			if err := os.WriteFile(filename, after, 0o644); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
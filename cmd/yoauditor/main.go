@@ -0,0 +1,126 @@
+// Command yoauditor audits Go source for common resource-handling and
+// security antipatterns.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sharafdin/yoauditor/auditor"
+	"github.com/sharafdin/yoauditor/fixer"
+	"github.com/sharafdin/yoauditor/report"
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+func main() {
+	policyDir := flag.String("policy-dir", "", "directory (or bundle) of Rego policies to additionally evaluate; see rules/common for a starter bundle")
+	gosec := flag.Bool("gosec", false, "also run gosec's rule set alongside yoauditor's own rules")
+	config := flag.String("config", ".yoauditor.yml", "path to a .yoauditor.yml with enable/disable and severity-override state for yoauditor's and gosec's rules")
+	envManifest := flag.String("env-manifest", "", "path to an envcheck manifest YAML file; if set, reports env vars read in source but undeclared, and vars declared but never read")
+	fix := flag.Bool("fix", false, "rewrite files in place using each finding's suggested fix, instead of reporting")
+	diff := flag.Bool("diff", false, "with -fix, print a unified diff of what would change instead of writing files")
+	format := flag.String("fmt", "text", "output format: text, json, sarif, or junit")
+	out := flag.String("out", "", "file to write the report to (default: stdout)")
+	failOn := flag.String("fail-on", "", "exit 1 if any finding is at least this severe (critical, high, medium, low, info); default: any finding")
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	if *fix {
+		if err := runFix(paths, *diff); err != nil {
+			fmt.Fprintln(os.Stderr, "yoauditor:", err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	findings, err := auditor.Run(paths, auditor.Options{PolicyDir: *policyDir, UseGosec: *gosec, ConfigPath: *config, EnvManifestPath: *envManifest})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "yoauditor:", err)
+		os.Exit(2)
+	}
+
+	w, closeW, err := reportWriter(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "yoauditor:", err)
+		os.Exit(2)
+	}
+	defer closeW()
+
+	if err := report.Write(w, report.Format(*format), toReportFindings(findings)); err != nil {
+		fmt.Fprintln(os.Stderr, "yoauditor:", err)
+		os.Exit(2)
+	}
+
+	if len(findings) == 0 {
+		return
+	}
+	threshold := rules.Severity(*failOn)
+	if threshold == "" || report.FailsThreshold(toReportFindings(findings), threshold) {
+		os.Exit(1)
+	}
+}
+
+// reportWriter opens path for the report output, or stdout if path is
+// empty. The returned close func is always safe to call.
+func reportWriter(path string) (io.Writer, func(), error) {
+	if path == "" {
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { f.Close() }, nil
+}
+
+// toReportFindings adapts auditor's Findings to report's, which drops
+// yoauditor's internal subsystem wiring in favor of the plain
+// file/line/column shape every output format renders.
+func toReportFindings(findings []auditor.Finding) []report.Finding {
+	out := make([]report.Finding, len(findings))
+	for i, f := range findings {
+		out[i] = report.Finding{
+			RuleID:       f.RuleID,
+			Severity:     f.Severity,
+			File:         f.Pos.Filename,
+			Line:         f.Pos.Line,
+			Column:       f.Pos.Column,
+			Message:      f.Message,
+			Snippet:      f.Snippet,
+			SuggestedFix: f.SuggestedFix,
+		}
+	}
+	return out
+}
+
+// runFix drives auditor.Fix, either writing changed files in place or,
+// with dryRun set (-fix -diff), printing a unified diff per changed file
+// and leaving the tree untouched.
+func runFix(paths []string, dryRun bool) error {
+	results, err := auditor.Fix(paths, !dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if !r.Changed {
+			continue
+		}
+		if dryRun {
+			diff, err := fixer.Diff(r.Filename, r.Before, r.After)
+			if err != nil {
+				return err
+			}
+			os.Stdout.Write(diff)
+			continue
+		}
+		fmt.Println("fixed", r.Filename)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package envcheck
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest declares every environment variable a program is supposed to
+// use, so Scan can diff it against the os.Getenv/os.LookupEnv call
+// sites actually found in source.
+type Manifest struct {
+	Vars []ManifestVar `yaml:"vars"`
+}
+
+// ManifestVar documents a single declared environment variable.
+type ManifestVar struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+	Default     string `yaml:"default"`
+	Secret      bool   `yaml:"secret"`
+}
+
+// LoadManifest reads and parses a YAML manifest file.
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+	var m Manifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return Manifest{}, err
+	}
+	return m, nil
+}
+
+// Names returns the set of variable names declared in the manifest.
+func (m Manifest) Names() map[string]bool {
+	names := make(map[string]bool, len(m.Vars))
+	for _, v := range m.Vars {
+		names[v.Name] = true
+	}
+	return names
+}
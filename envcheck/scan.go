@@ -0,0 +1,119 @@
+package envcheck
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+
+	"github.com/sharafdin/yoauditor/internal/gofiles"
+)
+
+// Usage is one os.Getenv/os.LookupEnv call site found by Scan.
+type Usage struct {
+	Name string
+	Pos  token.Position
+}
+
+// Report is the result of diffing the environment variables actually
+// read in source against a Manifest.
+type Report struct {
+	// Undeclared are call sites reading a variable missing from the
+	// manifest.
+	Undeclared []Usage
+	// Unused are variables declared in the manifest but never read
+	// anywhere in the scanned source.
+	Unused []string
+}
+
+// Scan walks paths (files or directories) for Go source, finds every
+// os.Getenv/os.LookupEnv call site that reads a variable named with a
+// string literal, and diffs the result against manifest.
+//
+// Call sites whose variable name isn't a string literal (e.g. built
+// from a variable or config value) can't be attributed statically and
+// are skipped, same as gosec's taint analysis only follows traceable
+// sources.
+func Scan(paths []string, manifest Manifest) (Report, error) {
+	files, err := gofiles.List(paths)
+	if err != nil {
+		return Report{}, err
+	}
+
+	fset := token.NewFileSet()
+	used := make(map[string][]token.Position)
+	for _, filename := range files {
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return Report{}, err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name, ok := getenvArgName(call)
+			if !ok {
+				return true
+			}
+			used[name] = append(used[name], fset.Position(call.Pos()))
+			return true
+		})
+	}
+
+	declared := manifest.Names()
+
+	var report Report
+	for name, positions := range used {
+		if declared[name] {
+			continue
+		}
+		for _, pos := range positions {
+			report.Undeclared = append(report.Undeclared, Usage{Name: name, Pos: pos})
+		}
+	}
+	for name := range declared {
+		if _, ok := used[name]; !ok {
+			report.Unused = append(report.Unused, name)
+		}
+	}
+
+	sort.Slice(report.Undeclared, func(i, j int) bool {
+		if report.Undeclared[i].Name != report.Undeclared[j].Name {
+			return report.Undeclared[i].Name < report.Undeclared[j].Name
+		}
+		return report.Undeclared[i].Pos.Offset < report.Undeclared[j].Pos.Offset
+	})
+	sort.Strings(report.Unused)
+
+	return report, nil
+}
+
+// getenvArgName reports the literal variable name passed to an
+// os.Getenv or os.LookupEnv call, if any.
+func getenvArgName(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.Name != "os" {
+		return "", false
+	}
+	if sel.Sel.Name != "Getenv" && sel.Sel.Name != "LookupEnv" {
+		return "", false
+	}
+	if len(call.Args) != 1 {
+		return "", false
+	}
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	name, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return name, true
+}
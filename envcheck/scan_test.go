@@ -0,0 +1,49 @@
+package envcheck_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/envcheck"
+)
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+	const src = `package a
+
+import "os"
+
+func getEnv(key string) string {
+	return os.Getenv(key)
+}
+
+func config() {
+	_ = os.Getenv("PORT")
+	_, _ = os.LookupEnv("LOG_LEVEL")
+}
+`
+	path := filepath.Join(dir, "a.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := envcheck.Manifest{
+		Vars: []envcheck.ManifestVar{
+			{Name: "PORT"},
+			{Name: "UNUSED_VAR"},
+		},
+	}
+
+	report, err := envcheck.Scan([]string{dir}, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Undeclared) != 1 || report.Undeclared[0].Name != "LOG_LEVEL" {
+		t.Errorf("Undeclared = %+v, want a single LOG_LEVEL entry", report.Undeclared)
+	}
+	if len(report.Unused) != 1 || report.Unused[0] != "UNUSED_VAR" {
+		t.Errorf("Unused = %v, want [UNUSED_VAR]", report.Unused)
+	}
+}
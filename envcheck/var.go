@@ -0,0 +1,117 @@
+// Package envcheck validates the environment variables a program reads
+// and audits a codebase's os.Getenv/os.LookupEnv call sites against a
+// declared Manifest of the variables it's supposed to use.
+package envcheck
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+)
+
+// Option configures how Var validates and reports an environment
+// variable.
+type Option func(*varSpec)
+
+type varSpec struct {
+	required bool
+	def      string
+	hasDef   bool
+	oneOf    []string
+	pattern  *regexp.Regexp
+	intRange *intRange
+	secret   bool
+}
+
+type intRange struct{ min, max int }
+
+// Required fails Var if the variable is unset and has no Default.
+func Required() Option {
+	return func(s *varSpec) { s.required = true }
+}
+
+// Default supplies a value to use when the variable is unset.
+func Default(v string) Option {
+	return func(s *varSpec) {
+		s.def = v
+		s.hasDef = true
+	}
+}
+
+// OneOf fails Var unless the value is one of allowed.
+func OneOf(allowed ...string) Option {
+	return func(s *varSpec) { s.oneOf = allowed }
+}
+
+// Regex fails Var unless the value matches re.
+func Regex(re *regexp.Regexp) Option {
+	return func(s *varSpec) { s.pattern = re }
+}
+
+// Int fails Var unless the value parses as a base-10 integer in
+// [min, max].
+func Int(min, max int) Option {
+	return func(s *varSpec) { s.intRange = &intRange{min, max} }
+}
+
+// Secret marks the variable as sensitive, so Var redacts its value in
+// any error message it returns.
+func Secret() Option {
+	return func(s *varSpec) { s.secret = true }
+}
+
+// Var reads and validates the named environment variable according to
+// opts. With no options it behaves like os.Getenv: a missing variable
+// yields "", nil.
+func Var(name string, opts ...Option) (string, error) {
+	spec := &varSpec{}
+	for _, opt := range opts {
+		opt(spec)
+	}
+
+	v, ok := os.LookupEnv(name)
+	switch {
+	case ok:
+		// fall through to validation below
+	case spec.hasDef:
+		v = spec.def
+	case spec.required:
+		return "", fmt.Errorf("envcheck: %s is required but not set", name)
+	default:
+		return "", nil
+	}
+
+	if len(spec.oneOf) > 0 && !contains(spec.oneOf, v) {
+		return "", fmt.Errorf("envcheck: %s=%s is not one of %v", name, redact(spec, v), spec.oneOf)
+	}
+	if spec.pattern != nil && !spec.pattern.MatchString(v) {
+		return "", fmt.Errorf("envcheck: %s=%s does not match %s", name, redact(spec, v), spec.pattern)
+	}
+	if spec.intRange != nil {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return "", fmt.Errorf("envcheck: %s=%s is not an integer", name, redact(spec, v))
+		}
+		if n < spec.intRange.min || n > spec.intRange.max {
+			return "", fmt.Errorf("envcheck: %s=%s is outside [%d, %d]", name, redact(spec, v), spec.intRange.min, spec.intRange.max)
+		}
+	}
+	return v, nil
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(spec *varSpec, v string) string {
+	if spec.secret {
+		return "[REDACTED]"
+	}
+	return v
+}
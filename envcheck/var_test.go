@@ -0,0 +1,86 @@
+package envcheck_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/envcheck"
+)
+
+func TestVarDefault(t *testing.T) {
+	os.Unsetenv("YOA_TEST_UNSET")
+
+	v, err := envcheck.Var("YOA_TEST_UNSET", envcheck.Default("fallback"))
+	if err != nil {
+		t.Fatalf("Var() error = %v", err)
+	}
+	if v != "fallback" {
+		t.Errorf("Var() = %q, want %q", v, "fallback")
+	}
+}
+
+func TestVarRequiredMissing(t *testing.T) {
+	os.Unsetenv("YOA_TEST_REQUIRED")
+
+	if _, err := envcheck.Var("YOA_TEST_REQUIRED", envcheck.Required()); err == nil {
+		t.Error("Var() with Required() on an unset variable = nil error, want one")
+	}
+}
+
+func TestVarOneOf(t *testing.T) {
+	t.Setenv("YOA_TEST_ONEOF", "prod")
+
+	if _, err := envcheck.Var("YOA_TEST_ONEOF", envcheck.OneOf("dev", "staging", "prod")); err != nil {
+		t.Errorf("Var() error = %v, want nil", err)
+	}
+
+	t.Setenv("YOA_TEST_ONEOF", "bogus")
+	if _, err := envcheck.Var("YOA_TEST_ONEOF", envcheck.OneOf("dev", "staging", "prod")); err == nil {
+		t.Error("Var() with an out-of-set value = nil error, want one")
+	}
+}
+
+func TestVarRegex(t *testing.T) {
+	t.Setenv("YOA_TEST_REGEX", "v1.2.3")
+
+	re := regexp.MustCompile(`^v\d+\.\d+\.\d+$`)
+	if _, err := envcheck.Var("YOA_TEST_REGEX", envcheck.Regex(re)); err != nil {
+		t.Errorf("Var() error = %v, want nil", err)
+	}
+
+	t.Setenv("YOA_TEST_REGEX", "not-a-version")
+	if _, err := envcheck.Var("YOA_TEST_REGEX", envcheck.Regex(re)); err == nil {
+		t.Error("Var() with a non-matching value = nil error, want one")
+	}
+}
+
+func TestVarInt(t *testing.T) {
+	t.Setenv("YOA_TEST_INT", "8080")
+
+	if _, err := envcheck.Var("YOA_TEST_INT", envcheck.Int(1, 65535)); err != nil {
+		t.Errorf("Var() error = %v, want nil", err)
+	}
+
+	t.Setenv("YOA_TEST_INT", "99999")
+	if _, err := envcheck.Var("YOA_TEST_INT", envcheck.Int(1, 65535)); err == nil {
+		t.Error("Var() with an out-of-range value = nil error, want one")
+	}
+
+	t.Setenv("YOA_TEST_INT", "not-a-number")
+	if _, err := envcheck.Var("YOA_TEST_INT", envcheck.Int(1, 65535)); err == nil {
+		t.Error("Var() with a non-integer value = nil error, want one")
+	}
+}
+
+func TestVarSecretRedacted(t *testing.T) {
+	t.Setenv("YOA_TEST_SECRET", "sup3rsecret")
+
+	_, err := envcheck.Var("YOA_TEST_SECRET", envcheck.OneOf("other"), envcheck.Secret())
+	if err == nil {
+		t.Fatal("Var() = nil error, want one")
+	}
+	if got := err.Error(); regexp.MustCompile(`sup3rsecret`).MatchString(got) {
+		t.Errorf("error %q leaks the secret value", got)
+	}
+}
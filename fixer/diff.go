@@ -0,0 +1,59 @@
+package fixer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Diff returns a unified diff between before and after labelled with
+// filename, for -fix -diff's dry-run output. It returns nil if the two
+// are identical. Like gofmt's -d flag, it shells out to the system diff
+// utility rather than vendoring a diff algorithm.
+func Diff(filename string, before, after []byte) ([]byte, error) {
+	if bytes.Equal(before, after) {
+		return nil, nil
+	}
+
+	beforeFile, err := os.CreateTemp("", "yoauditor-before-*.go")
+	if err != nil {
+		return nil, fmt.Errorf("fixer: %s: %w", filename, err)
+	}
+	defer os.Remove(beforeFile.Name())
+	afterFile, err := os.CreateTemp("", "yoauditor-after-*.go")
+	if err != nil {
+		return nil, fmt.Errorf("fixer: %s: %w", filename, err)
+	}
+	defer os.Remove(afterFile.Name())
+
+	if err := writeAndClose(beforeFile, before); err != nil {
+		return nil, fmt.Errorf("fixer: %s: %w", filename, err)
+	}
+	if err := writeAndClose(afterFile, after); err != nil {
+		return nil, fmt.Errorf("fixer: %s: %w", filename, err)
+	}
+
+	out, err := exec.Command("diff", "-u", beforeFile.Name(), afterFile.Name()).Output()
+	if err != nil {
+		// diff exits 1 when the inputs differ, which is the expected
+		// outcome here, not a failure.
+		if _, ok := err.(*exec.ExitError); !ok {
+			return nil, fmt.Errorf("fixer: %s: running diff: %w", filename, err)
+		}
+	}
+
+	s := string(out)
+	s = strings.Replace(s, beforeFile.Name(), filename+".orig", 1)
+	s = strings.Replace(s, afterFile.Name(), filename, 1)
+	return []byte(s), nil
+}
+
+func writeAndClose(f *os.File, data []byte) error {
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
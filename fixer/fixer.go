@@ -0,0 +1,71 @@
+// Package fixer applies the SuggestedFixes a yoauditor analyzer attaches
+// to its diagnostics, turning them into rewritten source. It is
+// deliberately decoupled from any particular analyzer: it operates on
+// the same []analysis.Diagnostic shape every analysis.Analyzer produces,
+// so adding a second fixable analyzer later needs no changes here.
+package fixer
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/imports"
+)
+
+// Apply rewrites src by splicing in the first SuggestedFix of every
+// diagnostic in diags, then runs the result through go/format and
+// goimports so the rewritten file stays well-formed. It returns src
+// unchanged if no diagnostic carries a fix.
+func Apply(fset *token.FileSet, filename string, src []byte, diags []analysis.Diagnostic) ([]byte, error) {
+	var edits []analysis.TextEdit
+	for _, diag := range diags {
+		if len(diag.SuggestedFixes) == 0 {
+			continue
+		}
+		edits = append(edits, diag.SuggestedFixes[0].TextEdits...)
+	}
+	if len(edits) == 0 {
+		return src, nil
+	}
+
+	out, err := applyEdits(fset, src, edits)
+	if err != nil {
+		return nil, fmt.Errorf("fixer: %s: %w", filename, err)
+	}
+
+	out, err = format.Source(out)
+	if err != nil {
+		return nil, fmt.Errorf("fixer: %s: formatting fixed source: %w", filename, err)
+	}
+	out, err = imports.Process(filename, out, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fixer: %s: fixing imports: %w", filename, err)
+	}
+	return out, nil
+}
+
+// applyEdits splices non-overlapping edits into src in position order,
+// converting each edit's token.Pos to a byte offset via fset. It fails
+// if two edits overlap, which would make the result ambiguous.
+func applyEdits(fset *token.FileSet, src []byte, edits []analysis.TextEdit) ([]byte, error) {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Pos < edits[j].Pos })
+
+	var out bytes.Buffer
+	offset := 0
+	for _, edit := range edits {
+		start := fset.Position(edit.Pos).Offset
+		end := fset.Position(edit.End).Offset
+		if start < offset {
+			return nil, fmt.Errorf("overlapping edits at offset %d", start)
+		}
+		out.Write(src[offset:start])
+		out.Write(edit.NewText)
+		offset = end
+	}
+	out.Write(src[offset:])
+	return out.Bytes(), nil
+}
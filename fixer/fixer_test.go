@@ -0,0 +1,135 @@
+package fixer_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/sharafdin/yoauditor/analyzers/deferloop"
+	"github.com/sharafdin/yoauditor/fixer"
+)
+
+// TestApplyMatchesDeferloopGolden runs the deferloop analyzer over its
+// own test fixture and checks that fixer.Apply produces exactly the
+// .golden file analysistest already verifies, so the two ways of
+// applying a SuggestedFix (analysistest's and the CLI's) stay in sync.
+func TestApplyMatchesDeferloopGolden(t *testing.T) {
+	const path = "../analyzers/deferloop/testdata/src/a/a.go"
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(path + ".golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", before, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diags []analysis.Diagnostic
+	pass := &analysis.Pass{
+		Fset:     fset,
+		Files:    []*ast.File{file},
+		ResultOf: map[*analysis.Analyzer]interface{}{inspect.Analyzer: inspector.New([]*ast.File{file})},
+		Report:   func(d analysis.Diagnostic) { diags = append(diags, d) },
+		ReadFile: os.ReadFile,
+	}
+	if _, err := deferloop.Analyzer.Run(pass); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fixer.Apply(fset, "a.go", before, diags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Apply() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestApplyLeavesNestedLoopDeferUnchanged guards the -fix path against a
+// regression of the use-after-close bug fixed in deferloop.buildFix:
+// running Apply on a fixture where the defer isn't the loop body's last
+// statement must leave the file untouched rather than closing the
+// resource in a spot where later code still uses it.
+func TestApplyLeavesNestedLoopDeferUnchanged(t *testing.T) {
+	const path = "../analyzers/deferloop/testdata/src/nestedloop/nestedloop.go"
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "nestedloop.go", before, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diags []analysis.Diagnostic
+	pass := &analysis.Pass{
+		Fset:     fset,
+		Files:    []*ast.File{file},
+		ResultOf: map[*analysis.Analyzer]interface{}{inspect.Analyzer: inspector.New([]*ast.File{file})},
+		Report:   func(d analysis.Diagnostic) { diags = append(diags, d) },
+		ReadFile: os.ReadFile,
+	}
+	if _, err := deferloop.Analyzer.Run(pass); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range diags {
+		if len(d.SuggestedFixes) != 0 {
+			t.Fatalf("diagnostic %q carries a SuggestedFix; want none for this fixture", d.Message)
+		}
+	}
+
+	got, err := fixer.Apply(fset, "nestedloop.go", before, diags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(before) {
+		t.Errorf("Apply() modified a file with no safe fix:\n%s", got)
+	}
+}
+
+func TestApplyNoDiagnosticsReturnsSrcUnchanged(t *testing.T) {
+	fset := token.NewFileSet()
+	src := []byte("package a\n")
+	got, err := fixer.Apply(fset, "a.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(src) {
+		t.Errorf("Apply() = %q, want src unchanged %q", got, src)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := []byte("package a\n\nfunc f() {}\n")
+	after := []byte("package a\n\nfunc g() {}\n")
+
+	got, err := fixer.Diff("a.go", before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) == 0 {
+		t.Fatal("Diff() = empty, want a unified diff")
+	}
+
+	same, err := fixer.Diff("a.go", before, before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same != nil {
+		t.Errorf("Diff() for identical inputs = %q, want nil", same)
+	}
+}
@@ -1,4 +1,11 @@
+//go:build ignore
+
 // Intentional issues: defer in loop (resource leak), error ignored
+//
+// This file is reference material only (no func main): it's the
+// antipattern sample that the analyzers, rules and policies elsewhere in
+// this module are written against. Keep it out of the build graph with
+// the ignore tag above instead of compiling it as a real program.
 
 package main
 
@@ -0,0 +1,46 @@
+// Package gofiles expands a list of files or directories into the flat
+// list of .go files under them, the same way across every yoauditor
+// subsystem that walks source trees (auditor, envcheck, ...).
+package gofiles
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// List expands paths (files or directories) into a flat list of .go
+// files, skipping directories whose names start with "." or "_" and
+// directories named vendor.
+func List(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if p != path && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "_") || d.Name() == "vendor") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(p, ".go") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
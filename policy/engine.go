@@ -0,0 +1,131 @@
+// Package policy lets users write custom yoauditor rules as Rego
+// policies and evaluate them against Go source serialized into a small
+// AST schema (see Node), instead of writing a Go Rule.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/open-policy-agent/opa/rego"
+
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+// Finding is a single result produced by evaluating a bundle's deny
+// rules against a file.
+type Finding struct {
+	Rule     string
+	Message  string
+	Severity rules.Severity
+	Pos      token.Position
+}
+
+// Engine evaluates a loaded Rego bundle's "data.yoauditor.deny" rule
+// against Go source.
+type Engine struct {
+	query rego.PreparedEvalQuery
+	ready bool
+}
+
+// NewEngine returns an Engine with no bundle loaded yet.
+func NewEngine() *Engine {
+	return &Engine{}
+}
+
+// LoadBundle loads the Rego policies at dir (a directory or a
+// compressed bundle file, per OPA's bundle format) and prepares them
+// for evaluation.
+func (e *Engine) LoadBundle(dir string) error {
+	query, err := rego.New(
+		rego.Query("data.yoauditor.deny"),
+		rego.LoadBundle(dir),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		return fmt.Errorf("policy: loading bundle %s: %w", dir, err)
+	}
+	e.query = query
+	e.ready = true
+	return nil
+}
+
+// Evaluate runs the loaded bundle's deny rules against file.
+func (e *Engine) Evaluate(fset *token.FileSet, file *ast.File) ([]Finding, error) {
+	if !e.ready {
+		return nil, fmt.Errorf("policy: no bundle loaded")
+	}
+
+	input := BuildNode(fset, file)
+	results, err := e.query.Eval(context.Background(), rego.EvalInput(input))
+	if err != nil {
+		return nil, fmt.Errorf("policy: evaluating: %w", err)
+	}
+
+	var findings []Finding
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			entries, ok := expr.Value.([]interface{})
+			if !ok {
+				continue
+			}
+			for _, entry := range entries {
+				finding, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				findings = append(findings, Finding{
+					Rule:     stringField(finding, "rule"),
+					Message:  stringField(finding, "message"),
+					Severity: severityField(finding),
+					Pos:      parsePos(stringField(finding, "pos")),
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// severityField reads a finding's "severity" key, falling back to
+// SeverityMedium when it's missing or not one yoauditor recognizes, so
+// a bundle that doesn't set one still gates a -fail-on run rather than
+// silently ranking below every real severity.
+func severityField(m map[string]interface{}) rules.Severity {
+	switch sev := rules.Severity(stringField(m, "severity")); sev {
+	case rules.SeverityCritical, rules.SeverityHigh, rules.SeverityMedium, rules.SeverityLow, rules.SeverityInfo:
+		return sev
+	default:
+		return rules.SeverityMedium
+	}
+}
+
+// parsePos converts a position string of the form
+// "file:line:column" (as produced by token.Position.String() when a
+// position has both file and line info, which is what policy.Node.Pos
+// always carries) back into a token.Position. It degrades gracefully
+// for any other shape, including the "-" token.Position.String() uses
+// for an invalid position.
+func parsePos(s string) token.Position {
+	parts := strings.Split(s, ":")
+	if len(parts) < 3 {
+		return token.Position{Filename: s}
+	}
+	line, lineErr := strconv.Atoi(parts[len(parts)-2])
+	column, colErr := strconv.Atoi(parts[len(parts)-1])
+	if lineErr != nil || colErr != nil {
+		return token.Position{Filename: s}
+	}
+	return token.Position{
+		Filename: strings.Join(parts[:len(parts)-2], ":"),
+		Line:     line,
+		Column:   column,
+	}
+}
@@ -0,0 +1,71 @@
+package policy_test
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/policy"
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+func TestEngineEvaluateDeferInLoop(t *testing.T) {
+	const src = `package a
+
+import "os"
+
+func processFiles(paths []string) {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		defer f.Close()
+	}
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := policy.NewEngine()
+	if err := engine.LoadBundle("../rules/common"); err != nil {
+		t.Fatalf("LoadBundle: %v", err)
+	}
+
+	findings, err := engine.Evaluate(fset, file)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+
+	var got *policy.Finding
+	for i, f := range findings {
+		if f.Rule == "policy.defer-in-loop" {
+			got = &findings[i]
+		}
+	}
+	if got == nil {
+		t.Fatalf("Evaluate(...) = %+v, want a policy.defer-in-loop finding", findings)
+	}
+	if got.Severity != rules.SeverityHigh {
+		t.Errorf("Severity = %q, want %q", got.Severity, rules.SeverityHigh)
+	}
+	if got.Pos.Filename != "a.go" || got.Pos.Line != 11 {
+		t.Errorf("Pos = %+v, want a.go:11:*", got.Pos)
+	}
+}
+
+func TestEngineEvaluateWithoutBundle(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", "package a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := policy.NewEngine()
+	if _, err := engine.Evaluate(fset, file); err == nil {
+		t.Error("Evaluate() with no bundle loaded = nil error, want one")
+	}
+}
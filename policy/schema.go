@@ -0,0 +1,77 @@
+package policy
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+)
+
+// Node is the JSON-serializable view of an ast.Node that yoauditor's
+// Rego policies evaluate against: node.kind, node.name, node.pos and
+// node.children.
+type Node struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name,omitempty"`
+	Pos      string `json:"pos,omitempty"`
+	Children []Node `json:"children,omitempty"`
+}
+
+// BuildNode converts an ast.Node (typically an *ast.File) into the Node
+// schema, using fset to render human-readable positions.
+func BuildNode(fset *token.FileSet, root ast.Node) Node {
+	var out Node
+	stack := []*Node{}
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		node := Node{
+			Kind: kindOf(n),
+			Name: nameOf(n),
+			Pos:  fset.Position(n.Pos()).String(),
+		}
+		if len(stack) == 0 {
+			out = node
+			stack = append(stack, &out)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, node)
+			stack = append(stack, &parent.Children[len(parent.Children)-1])
+		}
+		return true
+	})
+	return out
+}
+
+func kindOf(n ast.Node) string {
+	return reflect.TypeOf(n).Elem().Name()
+}
+
+// nameOf extracts a human-meaningful name for the node kinds policies
+// commonly key off of; everything else is left blank.
+func nameOf(n ast.Node) string {
+	switch v := n.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.FuncDecl:
+		return v.Name.Name
+	case *ast.TypeSpec:
+		return v.Name.Name
+	case *ast.BasicLit:
+		return v.Value
+	case *ast.SelectorExpr:
+		if pkg, ok := v.X.(*ast.Ident); ok {
+			return pkg.Name + "." + v.Sel.Name
+		}
+		return v.Sel.Name
+	case *ast.CallExpr:
+		switch fun := v.Fun.(type) {
+		case *ast.Ident:
+			return fun.Name
+		case *ast.SelectorExpr:
+			return nameOf(fun)
+		}
+	}
+	return ""
+}
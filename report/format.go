@@ -0,0 +1,34 @@
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+// Format selects how Write renders findings.
+type Format string
+
+// The formats Write supports.
+const (
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatSARIF Format = "sarif"
+	FormatJUnit Format = "junit"
+)
+
+// Write renders findings in the given format to w. An empty format
+// behaves like FormatText.
+func Write(w io.Writer, format Format, findings []Finding) error {
+	switch format {
+	case "", FormatText:
+		return writeText(w, findings)
+	case FormatJSON:
+		return writeJSON(w, findings)
+	case FormatSARIF:
+		return writeSARIF(w, findings)
+	case FormatJUnit:
+		return writeJUnit(w, findings)
+	default:
+		return fmt.Errorf("report: unknown format %q", format)
+	}
+}
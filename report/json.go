@@ -0,0 +1,12 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func writeJSON(w io.Writer, findings []Finding) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(findings)
+}
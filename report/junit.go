@@ -0,0 +1,60 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// JUnit XML has no notion of severity, so every finding becomes a
+// failed testcase: CI dashboards built around JUnit (most of them)
+// already treat a non-empty <failure> as the signal to surface.
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func writeJUnit(w io.Writer, findings []Finding) error {
+	suite := junitSuite{Name: "yoauditor", Tests: len(findings), Failures: len(findings)}
+	for _, f := range findings {
+		name := f.RuleID
+		if f.Line > 0 {
+			name = fmt.Sprintf("%s: %s:%d:%d", f.RuleID, f.File, f.Line, f.Column)
+		}
+		suite.Cases = append(suite.Cases, junitCase{
+			Name:      name,
+			ClassName: f.RuleID,
+			Failure:   &junitFailure{Message: f.Message, Text: f.Snippet},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitSuites{Suites: []junitSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
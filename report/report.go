@@ -0,0 +1,53 @@
+// Package report renders yoauditor findings in the formats CI pipelines
+// expect: plain text for a terminal, JSON for scripting, SARIF 2.1.0 for
+// GitHub Code Scanning and similar viewers, and JUnit XML for
+// test-result dashboards like the gosec and golangci-lint GitHub
+// Actions already use.
+package report
+
+import (
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+// Finding is one issue ready to render, independent of which of
+// yoauditor's subsystems (analyzer, rule, or policy) produced it.
+type Finding struct {
+	RuleID       string
+	Severity     rules.Severity
+	File         string
+	Line         int
+	Column       int
+	Message      string
+	Snippet      string
+	SuggestedFix string
+}
+
+// RuleMeta documents a rule ID for formats that embed rule metadata
+// (SARIF's tool.driver.rules), so a viewer like GitHub Code Scanning can
+// show a title and CWE reference instead of just the bare ID.
+type RuleMeta struct {
+	Name        string
+	Description string
+	HelpURI     string
+	// CWE is a "CWE-NNN" reference, embedded as a SARIF rule tag.
+	CWE string
+}
+
+// Rules catalogs the metadata for yoauditor's own Go rule IDs. Findings
+// from gosec, the envcheck manifest diff (envcheck.undeclared,
+// envcheck.unused) and Rego policies (policy.*) aren't listed here, so
+// their SARIF rule entries carry only the bare ID.
+var Rules = map[string]RuleMeta{
+	"deferloop": {
+		Name:        "defer-in-loop",
+		Description: "A defer inside a loop body only runs when the function returns, delaying cleanup until then instead of at the end of each iteration.",
+		HelpURI:     "https://pkg.go.dev/github.com/sharafdin/yoauditor/analyzers/deferloop",
+		CWE:         "CWE-404",
+	},
+	"YOA001": {
+		Name:        "getenv-no-default",
+		Description: "os.Getenv's result is returned or used with no default or validation, so a missing variable silently becomes an empty string.",
+		HelpURI:     "https://pkg.go.dev/github.com/sharafdin/yoauditor/rules",
+		CWE:         "CWE-1188",
+	},
+}
@@ -0,0 +1,130 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/report"
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+func sample() []report.Finding {
+	return []report.Finding{
+		{
+			RuleID:       "deferloop",
+			Severity:     rules.SeverityHigh,
+			File:         "a.go",
+			Line:         7,
+			Column:       3,
+			Message:      "defer f.Close() runs only when the function returns",
+			Snippet:      "defer f.Close()",
+			SuggestedFix: "close explicitly before each exit path instead of deferring",
+		},
+		{
+			RuleID:   "YOA001",
+			Severity: rules.SeverityMedium,
+			File:     "b.go",
+			Line:     12,
+			Column:   2,
+			Message:  `os.Getenv result returned without a default or validation`,
+		},
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Write(&buf, report.FormatText, sample()); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "a.go:7:3: deferloop:") {
+		t.Errorf("text output = %q, missing expected location prefix", buf.String())
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Write(&buf, report.FormatJSON, sample()); err != nil {
+		t.Fatal(err)
+	}
+	var got []report.Finding
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output isn't valid JSON: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d findings, want 2", len(got))
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Write(&buf, report.FormatSARIF, sample()); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output isn't valid JSON: %v", err)
+	}
+	if doc["version"] != "2.1.0" {
+		t.Errorf("version = %v, want 2.1.0", doc["version"])
+	}
+
+	runs := doc["runs"].([]interface{})
+	run := runs[0].(map[string]interface{})
+	rulesArr := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"].([]interface{})
+	if len(rulesArr) != 2 {
+		t.Fatalf("got %d rule entries, want 2 (one per distinct RuleID)", len(rulesArr))
+	}
+	deferloopRule := rulesArr[0].(map[string]interface{})
+	if deferloopRule["id"] != "deferloop" {
+		t.Fatalf("rules[0].id = %v, want deferloop", deferloopRule["id"])
+	}
+	props := deferloopRule["properties"].(map[string]interface{})
+	tags := props["tags"].([]interface{})
+	if tags[0] != "CWE-404" {
+		t.Errorf("deferloop rule tags = %v, want [CWE-404]", tags)
+	}
+
+	results := run["results"].([]interface{})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	first := results[0].(map[string]interface{})
+	if first["level"] != "error" {
+		t.Errorf("results[0].level = %v, want error (High maps to error)", first["level"])
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Write(&buf, report.FormatJUnit, sample()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `<testsuite name="yoauditor" tests="2" failures="2">`) {
+		t.Errorf("JUnit output missing expected testsuite header:\n%s", out)
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Write(&buf, report.Format("bogus"), sample()); err == nil {
+		t.Error("Write() with an unknown format = nil error, want one")
+	}
+}
+
+func TestFailsThreshold(t *testing.T) {
+	findings := sample() // High, Medium
+
+	if !report.FailsThreshold(findings, rules.SeverityHigh) {
+		t.Error("FailsThreshold(..., High) = false, want true (a High finding is present)")
+	}
+	if report.FailsThreshold(findings, rules.SeverityCritical) {
+		t.Error("FailsThreshold(..., Critical) = true, want false (no Critical finding)")
+	}
+	if !report.FailsThreshold(findings, rules.SeverityMedium) {
+		t.Error("FailsThreshold(..., Medium) = false, want true (Medium threshold is met by both findings)")
+	}
+}
@@ -0,0 +1,171 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string                 `json:"id"`
+	Name             string                 `json:"name,omitempty"`
+	ShortDescription sarifText              `json:"shortDescription"`
+	HelpURI          string                 `json:"helpUri,omitempty"`
+	Properties       map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifText       `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int        `json:"startLine,omitempty"`
+	StartColumn int        `json:"startColumn,omitempty"`
+	Snippet     *sarifText `json:"snippet,omitempty"`
+}
+
+type sarifFix struct {
+	Description sarifText `json:"description"`
+}
+
+func writeSARIF(w io.Writer, findings []Finding) error {
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{Driver: sarifDriver{
+					Name:           "yoauditor",
+					InformationURI: "https://pkg.go.dev/github.com/sharafdin/yoauditor",
+					Rules:          sarifRules(findings),
+				}},
+				Results: sarifResults(findings),
+			},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// sarifRules returns one entry per distinct rule ID in findings, in
+// first-seen order, embedding CWE and remediation metadata from Rules
+// where known.
+func sarifRules(findings []Finding) []sarifRule {
+	seen := make(map[string]bool)
+	var out []sarifRule
+	for _, f := range findings {
+		if seen[f.RuleID] {
+			continue
+		}
+		seen[f.RuleID] = true
+
+		rule := sarifRule{ID: f.RuleID}
+		if meta, ok := Rules[f.RuleID]; ok {
+			rule.Name = meta.Name
+			rule.ShortDescription = sarifText{Text: meta.Description}
+			rule.HelpURI = meta.HelpURI
+			if meta.CWE != "" {
+				rule.Properties = map[string]interface{}{"tags": []string{meta.CWE}}
+			}
+		}
+		out = append(out, rule)
+	}
+	return out
+}
+
+func sarifResults(findings []Finding) []sarifResult {
+	out := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		result := sarifResult{
+			RuleID:  f.RuleID,
+			Level:   sarifLevel(f.Severity),
+			Message: sarifText{Text: f.Message},
+		}
+		if f.File != "" {
+			result.Locations = []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: f.File},
+				Region:           sarifRegionFor(f),
+			}}}
+		}
+		if f.SuggestedFix != "" {
+			result.Fixes = []sarifFix{{Description: sarifText{Text: f.SuggestedFix}}}
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+func sarifRegionFor(f Finding) *sarifRegion {
+	if f.Line == 0 {
+		return nil
+	}
+	region := &sarifRegion{StartLine: f.Line, StartColumn: f.Column}
+	if f.Snippet != "" {
+		region.Snippet = &sarifText{Text: f.Snippet}
+	}
+	return region
+}
+
+// sarifLevel maps yoauditor's Severity onto SARIF's three result
+// levels, collapsing Critical and High onto "error" since SARIF doesn't
+// have a level above it.
+func sarifLevel(sev rules.Severity) string {
+	switch sev {
+	case rules.SeverityCritical, rules.SeverityHigh:
+		return "error"
+	case rules.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
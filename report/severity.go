@@ -0,0 +1,34 @@
+package report
+
+import "github.com/sharafdin/yoauditor/rules"
+
+// severityRank orders Severity from least to most urgent, so callers
+// can compare levels for a -fail-on style gate.
+var severityRank = map[rules.Severity]int{
+	rules.SeverityInfo:     0,
+	rules.SeverityLow:      1,
+	rules.SeverityMedium:   2,
+	rules.SeverityHigh:     3,
+	rules.SeverityCritical: 4,
+}
+
+// MeetsOrExceeds reports whether sev is at least as urgent as
+// threshold. An unrecognized severity ranks below every known one.
+func MeetsOrExceeds(sev, threshold rules.Severity) bool {
+	rank, ok := severityRank[sev]
+	if !ok {
+		rank = -1
+	}
+	return rank >= severityRank[threshold]
+}
+
+// FailsThreshold reports whether any finding is at least as urgent as
+// threshold, for a CLI's -fail-on flag.
+func FailsThreshold(findings []Finding, threshold rules.Severity) bool {
+	for _, f := range findings {
+		if MeetsOrExceeds(f.Severity, threshold) {
+			return true
+		}
+	}
+	return false
+}
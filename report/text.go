@@ -0,0 +1,21 @@
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+func writeText(w io.Writer, findings []Finding) error {
+	for _, f := range findings {
+		var err error
+		if f.Line > 0 {
+			_, err = fmt.Fprintf(w, "%s:%d:%d: %s: %s\n", f.File, f.Line, f.Column, f.RuleID, f.Message)
+		} else {
+			_, err = fmt.Fprintf(w, "%s: %s\n", f.RuleID, f.Message)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the subset of a .yoauditor.yml file that controls rule
+// enable/disable state and per-rule severity overrides.
+type Config struct {
+	Disable           []string          `yaml:"disable"`
+	Enable            []string          `yaml:"enable"`
+	SeverityOverrides map[string]string `yaml:"severity_overrides"`
+}
+
+// LoadConfig reads and parses a .yoauditor.yml file. A missing file is
+// not an error; it just means no overrides apply.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Config{}, nil
+	}
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,63 @@
+package rules_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ".yoauditor.yml")
+	const yml = `disable:
+  - G304
+severity_overrides:
+  YOA001: critical
+`
+	if err := os.WriteFile(path, []byte(yml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := rules.LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if len(cfg.Disable) != 1 || cfg.Disable[0] != "G304" {
+		t.Errorf("Disable = %v, want [G304]", cfg.Disable)
+	}
+	if cfg.SeverityOverrides["YOA001"] != "critical" {
+		t.Errorf("SeverityOverrides[YOA001] = %q, want critical", cfg.SeverityOverrides["YOA001"])
+	}
+}
+
+func TestLoadConfigMissingFileIsNotAnError(t *testing.T) {
+	cfg, err := rules.LoadConfig(filepath.Join(t.TempDir(), "missing.yml"))
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if len(cfg.Disable) != 0 || len(cfg.Enable) != 0 || len(cfg.SeverityOverrides) != 0 {
+		t.Errorf("LoadConfig(missing) = %+v, want zero value", cfg)
+	}
+}
+
+func TestRegistryApplyOverridesFiltersGosecIssues(t *testing.T) {
+	reg := rules.NewRegistry()
+	reg.Apply(rules.Config{
+		Disable:           []string{"G104"},
+		SeverityOverrides: map[string]string{"G304": "critical"},
+	})
+
+	issues := reg.ApplyOverrides([]rules.Issue{
+		{RuleID: "G104", Severity: rules.SeverityMedium, Message: "ignored error"},
+		{RuleID: "G304", Severity: rules.SeverityMedium, Message: "file inclusion"},
+	})
+
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1 (G104 disabled): %+v", len(issues), issues)
+	}
+	if issues[0].RuleID != "G304" || issues[0].Severity != rules.SeverityCritical {
+		t.Errorf("issue = %+v, want G304 at critical severity", issues[0])
+	}
+}
@@ -0,0 +1,48 @@
+package rules
+
+import "go/ast"
+
+// GetenvNoDefault flags a bare `return os.Getenv(key)`: the function
+// hands the caller whatever os.Getenv returned, including an empty
+// string when the variable is unset, with no required/default/
+// validation step in between.
+type GetenvNoDefault struct{}
+
+// NewGetenvNoDefault returns the GetenvNoDefault rule.
+func NewGetenvNoDefault() Rule { return GetenvNoDefault{} }
+
+// ID implements Rule.
+func (GetenvNoDefault) ID() string { return "YOA001" }
+
+// Severity implements Rule.
+func (GetenvNoDefault) Severity() Severity { return SeverityMedium }
+
+// Nodes implements Rule.
+func (GetenvNoDefault) Nodes() []ast.Node { return []ast.Node{(*ast.ReturnStmt)(nil)} }
+
+// Match implements Rule.
+func (r GetenvNoDefault) Match(n ast.Node, ctx *Context) (*Issue, error) {
+	ret := n.(*ast.ReturnStmt)
+	if len(ret.Results) != 1 {
+		return nil, nil
+	}
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok || !isOSGetenv(call) {
+		return nil, nil
+	}
+	return &Issue{
+		RuleID:   r.ID(),
+		Severity: r.Severity(),
+		Message:  `os.Getenv result returned without a default or validation; a missing variable silently becomes ""`,
+		Pos:      ret.Pos(),
+	}, nil
+}
+
+func isOSGetenv(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "os" && sel.Sel.Name == "Getenv"
+}
@@ -0,0 +1,75 @@
+package rules_test
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+func TestGetenvNoDefault(t *testing.T) {
+	const src = `package a
+
+import "os"
+
+func getEnv(key string) string {
+	return os.Getenv(key)
+}
+
+func getEnvWithDefault(key, fallback string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	return v
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := rules.NewRegistry()
+	reg.Register(rules.NewGetenvNoDefault())
+
+	issues, err := reg.Walk(fset, "a.go", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1: %+v", len(issues), issues)
+	}
+	if issues[0].RuleID != "YOA001" {
+		t.Errorf("RuleID = %q, want YOA001", issues[0].RuleID)
+	}
+	if got := fset.Position(issues[0].Pos).Line; got != 6 {
+		t.Errorf("reported on line %d, want 6", got)
+	}
+}
+
+func TestGetenvNoDefaultIgnoresOtherReturns(t *testing.T) {
+	const src = `package a
+
+func f() string {
+	return "ok"
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "a.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := rules.NewRegistry()
+	reg.Register(rules.NewGetenvNoDefault())
+
+	issues, err := reg.Walk(fset, "a.go", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("got %d issues, want 0: %+v", len(issues), issues)
+	}
+}
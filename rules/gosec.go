@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"io"
+	"log"
+	"strings"
+
+	gosec "github.com/securego/gosec/v2"
+	gosecrules "github.com/securego/gosec/v2/rules"
+)
+
+// DefaultGosecRuleIDs are the gosec rules yoauditor enables by default:
+// ignored errors, file-path taint, and deferred close of a writable
+// file. These are the categories that show up most often alongside the
+// antipatterns yoauditor's own hand-written rules already cover.
+var DefaultGosecRuleIDs = []string{"G104", "G304", "G307"}
+
+// RunGosec type-checks the packages at paths and runs gosec's own rule
+// visitors (scoped to ruleIDs) over them, adapting the results into
+// yoauditor Issues so they can be merged with findings from yoauditor's
+// own Registry.
+func RunGosec(paths []string, ruleIDs ...string) ([]Issue, error) {
+	logger := log.New(io.Discard, "", 0)
+	analyzer := gosec.NewAnalyzer(gosec.NewConfig(), false, false, false, 1, logger)
+
+	builders, suppressed := gosecrules.Generate(false, gosecrules.NewRuleFilter(false, ruleIDs...)).RulesInfo()
+	analyzer.LoadRules(builders, suppressed)
+
+	if err := analyzer.Process(nil, paths...); err != nil {
+		return nil, err
+	}
+
+	found, _, _ := analyzer.Report()
+	issues := make([]Issue, 0, len(found))
+	for _, iss := range found {
+		issues = append(issues, Issue{
+			RuleID:   iss.RuleID,
+			Severity: Severity(strings.ToLower(iss.Severity.String())),
+			Message:  iss.What,
+		})
+	}
+	return issues, nil
+}
@@ -0,0 +1,68 @@
+package rules_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sharafdin/yoauditor/rules"
+)
+
+// TestRunGosec exercises the real gosec analyzer (not a stub) against a
+// small on-disk package containing a G304-shaped file-path taint issue,
+// the same os.Open(path)-style call that appears in analyzers/deferloop's
+// own "a" fixture.
+func TestRunGosec(t *testing.T) {
+	dir := t.TempDir()
+	const src = `package p
+
+import "os"
+
+func read(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := rules.RunGosec([]string{dir}, rules.DefaultGosecRuleIDs...)
+	if err != nil {
+		t.Fatalf("RunGosec: %v", err)
+	}
+
+	var found bool
+	for _, issue := range issues {
+		if issue.RuleID == "G304" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("RunGosec(...) = %+v, want a G304 issue", issues)
+	}
+}
+
+func TestRunGosecScopesToRequestedRuleIDs(t *testing.T) {
+	dir := t.TempDir()
+	const src = `package p
+
+import "os"
+
+func read(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := rules.RunGosec([]string{dir}, "G104")
+	if err != nil {
+		t.Fatalf("RunGosec: %v", err)
+	}
+	for _, issue := range issues {
+		if issue.RuleID != "G104" {
+			t.Errorf("RunGosec(..., %q) reported %s, want only G104", "G104", issue.RuleID)
+		}
+	}
+}
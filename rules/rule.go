@@ -0,0 +1,44 @@
+// Package rules defines yoauditor's pluggable rule interface and a
+// registry that runs yoauditor's own AST-based rules alongside gosec's.
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Severity ranks how urgently a finding should be addressed.
+type Severity string
+
+// Severity levels, ordered from most to least urgent.
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+	SeverityInfo     Severity = "info"
+)
+
+// Context carries the state a Rule needs while visiting a file.
+type Context struct {
+	Fset     *token.FileSet
+	Filename string
+}
+
+// Issue is a single finding produced by a Rule.
+type Issue struct {
+	RuleID   string
+	Severity Severity
+	Message  string
+	Pos      token.Pos
+}
+
+// Rule inspects one AST node type at a time and reports at most one
+// Issue per node. Nodes declares which node types Match should be
+// called for, mirroring gosec's own RuleSet.Register.
+type Rule interface {
+	ID() string
+	Severity() Severity
+	Nodes() []ast.Node
+	Match(n ast.Node, ctx *Context) (*Issue, error)
+}